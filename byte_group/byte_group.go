@@ -0,0 +1,138 @@
+// Package byte_group 是 string_group 的 []byte 版本，遵循标准库 bytes 与
+// strings 互为镜像的惯例：所有分类逻辑直接在字节切片上完成，不做任何字符串
+// 转换，适合处理已经是 []byte 形式的大体量输入（文件内容、网络包体等）。
+package byte_group
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	sg "github.com/Servicewall/string-group"
+)
+
+// GroupType、StringSegment、StringGroups 直接复用 string_group 的定义——
+// 分组索引本身与底层数据是 []byte 还是 string 无关，没有必要重新定义同构的
+// 类型（以及与之配套的方法，如 MergeMultiGroups）。
+type (
+	GroupType     = sg.GroupType
+	StringSegment = sg.StringSegment
+	StringGroups  = sg.StringGroups
+)
+
+const (
+	GroupTypeChinese = sg.GroupTypeChinese
+	GroupTypeLetters = sg.GroupTypeLetters
+	GroupTypeDigits  = sg.GroupTypeDigits
+	GroupTypeOthers  = sg.GroupTypeOthers
+	GroupTypeCommon  = sg.GroupTypeCommon
+)
+
+var commonBytes = []byte{'*', 'X', 'x', '-', '(', ')', '_', '.', '@'}
+
+func isCommonByte(r rune) bool {
+	for _, c := range commonBytes {
+		if r == rune(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func classify(r rune) GroupType {
+	switch {
+	case isCommonByte(r):
+		return GroupTypeCommon
+	case unicode.Is(unicode.Han, r):
+		return GroupTypeChinese
+	case unicode.IsLetter(r):
+		return GroupTypeLetters
+	case unicode.IsDigit(r):
+		return GroupTypeDigits
+	default:
+		return GroupTypeOthers
+	}
+}
+
+func appendSegment(g *StringGroups, t GroupType, seg StringSegment) {
+	switch t {
+	case GroupTypeChinese:
+		g.Chinese = append(g.Chinese, seg)
+	case GroupTypeLetters:
+		g.Letters = append(g.Letters, seg)
+	case GroupTypeDigits:
+		g.Digits = append(g.Digits, seg)
+	case GroupTypeCommon:
+		g.Commons = append(g.Commons, seg)
+	default:
+		g.Others = append(g.Others, seg)
+	}
+}
+
+// SplitIntoGroups 是 string_group.SplitIntoGroups 的 []byte 版本：将 b 分为
+// 汉字、字母、数字、其他字符和通用字符五组，全程直接在字节切片上解码 rune，
+// 不对 b 做字符串转换。
+func SplitIntoGroups(b []byte) StringGroups {
+	result := StringGroups{
+		Chinese: make([]StringSegment, 0, len(b)/8+1),
+		Letters: make([]StringSegment, 0, len(b)/8+1),
+		Digits:  make([]StringSegment, 0, len(b)/8+1),
+		Others:  make([]StringSegment, 0, len(b)/8+1),
+		Commons: make([]StringSegment, 0, len(b)/8+1),
+	}
+
+	SplitIter(b, func(t GroupType, seg StringSegment) bool {
+		appendSegment(&result, t, seg)
+		return true
+	})
+	return result
+}
+
+// SplitIter 是 SplitIntoGroups 的流式版本，也是两者共用的唯一状态机：每当
+// 一个分组段结束就立即调用 yield，不预先为五个分组分配切片，适合处理体积
+// 很大的 []byte 输入。yield 返回 false 时停止扫描。
+func SplitIter(b []byte, yield func(GroupType, StringSegment) bool) {
+	start := 0
+	var currentType GroupType
+	hasSegment := false
+
+	emit := func(end int) bool {
+		if !hasSegment {
+			return true
+		}
+		return yield(currentType, StringSegment{Start: start, End: end})
+	}
+
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		charType := classify(r)
+
+		if !hasSegment {
+			start = i
+			hasSegment = true
+			currentType = charType
+		} else if charType != currentType {
+			if !emit(i) {
+				return
+			}
+			start = i
+			currentType = charType
+		}
+		i += size
+	}
+	emit(len(b))
+}
+
+// MergeMultiGroupsWithContinuousIntervals 是 (*StringGroups) 同名方法的
+// []byte 版本：originalBytes 仅用于 boundaryCheck 时判断边界字符，不会被
+// 转换为字符串。
+func MergeMultiGroupsWithContinuousIntervals(g *StringGroups, originalBytes []byte, boundaryCheck bool, maxlength, minLength int, types ...GroupType) []StringSegment {
+	merged := g.MergeMultiGroups(types...)
+	return connectContinuousIntervals(originalBytes, boundaryCheck, maxlength, minLength, merged)
+}
+
+// FilterSegmentsByIntervals 与 string_group.FilterSegmentsByIntervals 完全
+// 一致——该函数本身只基于字节偏移运算，不涉及字符串/[]byte 之分，这里提供
+// 同名转发以保持 API 对称，避免调用方为此单独引入 string_group 包。
+func FilterSegmentsByIntervals(intervals [][]int, segments []StringSegment) []StringSegment {
+	return sg.FilterSegmentsByIntervals(intervals, segments)
+}
@@ -0,0 +1,76 @@
+package byte_group
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// connectContinuousIntervals 是 string_group 中同名函数的 []byte 版本：
+// 连接 segments 中相邻（End == 下一个 Start）的区间，并按长度与边界条件过滤。
+// segments 只有一个区间时只按长度过滤，不做边界检查（与 string_group 一致）。
+func connectContinuousIntervals(originalBytes []byte, boundaryCheck bool, maxlength, minLength int, segments []StringSegment) []StringSegment {
+	if len(segments) <= 1 {
+		if len(segments) == 1 {
+			if length := segments[0].End - segments[0].Start; (minLength > 0 && length < minLength) || (maxlength != 0 && length > maxlength) {
+				return nil
+			}
+		}
+		return segments
+	}
+
+	result := make([]StringSegment, 0, len(segments))
+	current := segments[0]
+
+	keep := func(seg StringSegment) bool {
+		length := seg.End - seg.Start
+		if length < minLength || (maxlength != 0 && length > maxlength) {
+			return false
+		}
+		return !boundaryCheck || validBoundary(originalBytes, seg.Start, seg.End)
+	}
+
+	for i := 1; i < len(segments); i++ {
+		if current.End == segments[i].Start {
+			current.End = segments[i].End
+		} else {
+			if keep(current) {
+				result = append(result, current)
+			}
+			current = segments[i]
+		}
+	}
+	if keep(current) {
+		result = append(result, current)
+	}
+
+	return result
+}
+
+// validBoundary 是 string_group 中同名函数的 []byte 版本，直接在字节切片上
+// 解码边界处的 rune，不做字符串转换。
+func validBoundary(b []byte, start, end int) bool {
+	if start > 0 {
+		prevChar, _ := utf8.DecodeLastRune(b[:start])
+		if isIllegalCharacter(prevChar) {
+			return false
+		}
+	}
+	if end < len(b) {
+		nextChar, _ := utf8.DecodeRune(b[end:])
+		if isIllegalCharacter(nextChar) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIllegalCharacter(r rune) bool {
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return true
+	}
+	switch r {
+	case '.', '*', '-', '_', '|', '%':
+		return true
+	}
+	return false
+}
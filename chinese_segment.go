@@ -0,0 +1,101 @@
+package string_group
+
+import (
+	"unicode/utf8"
+
+	"github.com/Servicewall/string-group/segment"
+)
+
+// jumper 记录 Viterbi 动态规划中某个位置的最优路径信息。
+type jumper struct {
+	minDistance float32 // 到达该位置的最小累计代价（负对数概率之和）
+	tokenLen    int     // 到达该位置时，最后一个词的 rune 长度
+}
+
+const unknownTokenPenalty float32 = 20.0
+
+// SegmentChinese 使用基于词典的 Viterbi 分词算法，将 original 中的每个 Chinese
+// 分组进一步细分为词级别的子段。返回的切片与 sg.Chinese 一一对应，每个元素是
+// 该汉字段内部按词边界切分出的子段（字节偏移，相对于 original）。
+func (sg *StringGroups) SegmentChinese(original string, dict *segment.Dictionary) [][]StringSegment {
+	if dict == nil {
+		return nil
+	}
+
+	result := make([][]StringSegment, len(sg.Chinese))
+	for i, seg := range sg.Chinese {
+		result[i] = segmentOne(original, seg, dict)
+	}
+	return result
+}
+
+// segmentOne 对单个 Chinese 段运行 Viterbi 分词，返回按词切分后的子段（字节偏移）。
+func segmentOne(original string, seg StringSegment, dict *segment.Dictionary) []StringSegment {
+	text := original[seg.Start:seg.End]
+	runes := []rune(text)
+	n := len(runes)
+	if n == 0 {
+		return nil
+	}
+
+	// byteOffsets[i] 是第 i 个 rune 在 text 中的起始字节偏移，byteOffsets[n] 是 len(text)。
+	byteOffsets := make([]int, n+1)
+	offset := 0
+	for i, r := range runes {
+		byteOffsets[i] = offset
+		offset += utf8.RuneLen(r)
+	}
+	byteOffsets[n] = offset
+
+	jumpers := make([]jumper, n+1)
+	for i := 1; i <= n; i++ {
+		jumpers[i].minDistance = float32(1<<31 - 1)
+	}
+
+	for i := 0; i < n; i++ {
+		// 单字兜底：任何位置都允许长度为 1 的跳转，代价很高但保证可达。
+		relax(jumpers, i, 1, unknownTokenPenalty)
+
+		for _, m := range dict.MatchesAt(runes, i) {
+			relax(jumpers, i, m.Length, float32(-m.LogProbability))
+		}
+	}
+
+	// 从末尾回溯 tokenLen 还原词边界。
+	var tokens []int // 每个词的起始 rune 位置，逆序收集
+	for pos := n; pos > 0; {
+		tokenLen := jumpers[pos].tokenLen
+		if tokenLen == 0 {
+			tokenLen = 1
+		}
+		tokens = append(tokens, pos-tokenLen)
+		pos -= tokenLen
+	}
+
+	segments := make([]StringSegment, 0, len(tokens))
+	for i := len(tokens) - 1; i >= 0; i-- {
+		startRune := tokens[i]
+		endRune := n
+		if i > 0 {
+			endRune = tokens[i-1]
+		}
+		segments = append(segments, StringSegment{
+			Start: seg.Start + byteOffsets[startRune],
+			End:   seg.Start + byteOffsets[endRune],
+		})
+	}
+	return segments
+}
+
+// relax 尝试用从 i 跳 tokenLen 个 rune、代价为 cost 的路径去松弛 i+tokenLen 处的最优解。
+func relax(jumpers []jumper, i, tokenLen int, cost float32) {
+	to := i + tokenLen
+	if to >= len(jumpers) {
+		return
+	}
+	d := jumpers[i].minDistance + cost
+	if d < jumpers[to].minDistance {
+		jumpers[to].minDistance = d
+		jumpers[to].tokenLen = tokenLen
+	}
+}
@@ -0,0 +1,59 @@
+package string_group
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Servicewall/string-group/segment"
+)
+
+func testDictionary(t *testing.T) *segment.Dictionary {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "dict.txt")
+	content := "北京 1000 ns\n北京大学 500 nt\n大学 800 n\n清华 600 nt\n清华大学 400 nt\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("写入测试词典失败: %v", err)
+	}
+	dict, err := segment.LoadDictionary(path)
+	if err != nil {
+		t.Fatalf("加载测试词典失败: %v", err)
+	}
+	return dict
+}
+
+func TestSegmentChinese(t *testing.T) {
+	dict := testDictionary(t)
+	original := "我在北京大学abc清华123"
+	groups := SplitIntoGroups(original)
+
+	result := groups.SegmentChinese(original, dict)
+	if len(result) != len(groups.Chinese) {
+		t.Fatalf("SegmentChinese 返回的分段数应与 Chinese 分组数一致，got %d want %d", len(result), len(groups.Chinese))
+	}
+
+	var got []string
+	for _, segs := range result {
+		for _, s := range segs {
+			got = append(got, s.String(original))
+		}
+	}
+
+	want := []string{"我", "在", "北京大学", "清华"}
+	if len(got) != len(want) {
+		t.Fatalf("分词结果不符: got %v want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("分词结果不符: got %v want %v", got, want)
+		}
+	}
+}
+
+func TestSegmentChineseNilDictionary(t *testing.T) {
+	groups := SplitIntoGroups("北京大学")
+	if result := groups.SegmentChinese("北京大学", nil); result != nil {
+		t.Fatalf("dict 为 nil 时应返回 nil，实际 %+v", result)
+	}
+}
@@ -0,0 +1,136 @@
+package string_group
+
+import "unicode"
+
+// firstCustomGroupType 是通过 AddClass 注册的自定义分组的起始 GroupType 值，
+// 确保自定义分组不会与内置五类分组的常量冲突。
+const firstCustomGroupType GroupType = GroupTypeCommon + 1
+
+// classRule 是 Classifier 中注册的一条分类规则：按注册顺序匹配，first-match-wins。
+type classRule struct {
+	name      string
+	groupType GroupType
+	pred      func(r rune) bool
+}
+
+// Classifier 允许调用方注册自己的字符分类规则并据此切分字符串，不再局限于
+// 内置的汉字/字母/数字/其他/通用五类。规则按注册顺序逐一匹配，未命中任何规则
+// 的字符归入 GroupTypeOthers。这与 strings.FieldsFunc/bytes.FieldsFunc 参数化
+// 分词的思路类似，适合在不修改本包的情况下支持标识符、URL、货币代码等领域
+// 特定分组。
+type Classifier struct {
+	rules    []classRule
+	boundary func(r rune) bool
+	nextType GroupType
+}
+
+// NewClassifier 创建一个空的 Classifier，初始不含任何规则。
+func NewClassifier() *Classifier {
+	return &Classifier{nextType: firstCustomGroupType}
+}
+
+// AddClass 注册一条分类规则并返回为其分配的 GroupType。多次调用按注册顺序
+// first-match-wins 匹配，因此更具体的规则应先于更宽泛的规则注册。
+func (c *Classifier) AddClass(name string, pred func(r rune) bool) GroupType {
+	t := c.nextType
+	c.nextType++
+	c.rules = append(c.rules, classRule{name: name, groupType: t, pred: pred})
+	return t
+}
+
+// addBuiltin 以内置 GroupType 注册一条规则，仅供 defaultClassifier 组装
+// 向后兼容的默认分类器使用。
+func (c *Classifier) addBuiltin(groupType GroupType, pred func(r rune) bool) {
+	c.rules = append(c.rules, classRule{groupType: groupType, pred: pred})
+}
+
+// SetBoundary 设置一个边界谓词：命中该谓词的字符只结束当前段，自身不归入
+// 任何分组（既不参与当前段也不单独成段），常用于丢弃纯分隔符。未设置时不
+// 产生边界，行为与历史实现一致。
+func (c *Classifier) SetBoundary(pred func(r rune) bool) {
+	c.boundary = pred
+}
+
+// classify 返回 r 命中的第一条规则对应的 GroupType；没有规则命中时返回
+// (GroupTypeOthers, false)。
+func (c *Classifier) classify(r rune) (GroupType, bool) {
+	for _, rule := range c.rules {
+		if rule.pred(r) {
+			return rule.groupType, true
+		}
+	}
+	return GroupTypeOthers, false
+}
+
+// Split 按照已注册的规则将 s 切分为若干 StringGroups 段。它在内部复用
+// splitIter 这同一套状态机，只是把流式产出的分组段收集进预分配好的切片。
+func (c *Classifier) Split(s string) StringGroups {
+	result := StringGroups{
+		Chinese: make([]StringSegment, 0, len(s)/8+1),
+		Letters: make([]StringSegment, 0, len(s)/8+1),
+		Digits:  make([]StringSegment, 0, len(s)/8+1),
+		Others:  make([]StringSegment, 0, len(s)/8+1),
+		Commons: make([]StringSegment, 0, len(s)/8+1),
+	}
+
+	c.splitIter(s, func(t GroupType, seg StringSegment) bool {
+		result.appendSegment(t, seg)
+		return true
+	})
+	return result
+}
+
+// splitIter 是 Classifier 的核心状态机：逐字符扫描 s，每当一个分组段结束
+// （字符类型变化、命中边界字符或到达字符串末尾）就立即调用 yield，不在内部
+// 做任何缓冲或累积。yield 返回 false 时提前终止扫描，与 Go 1.23 的
+// range-over-func 迭代器约定一致。
+func (c *Classifier) splitIter(s string, yield func(GroupType, StringSegment) bool) {
+	start := 0 // 当前段的起始位置
+	var currentType GroupType
+	hasSegment := false // 是否已经开始一个段
+
+	// emit 在存在未闭合的段时将其产出给 yield，返回值表示是否应继续扫描。
+	emit := func(end int) bool {
+		if !hasSegment {
+			return true
+		}
+		return yield(currentType, StringSegment{Start: start, End: end})
+	}
+
+	for i, r := range s {
+		if c.boundary != nil && c.boundary(r) {
+			if !emit(i) {
+				return
+			}
+			hasSegment = false
+			continue
+		}
+
+		charType, _ := c.classify(r)
+
+		if !hasSegment {
+			start = i
+			hasSegment = true
+			currentType = charType
+		} else if charType != currentType {
+			if !emit(i) {
+				return
+			}
+			start = i
+			currentType = charType
+		}
+	}
+
+	emit(len(s))
+}
+
+// defaultClassifier 组装与历史 SplitIntoGroups 行为一致的默认分类器：依次判断
+// 通用字符、汉字、字母、数字，其余归入 Others。
+func defaultClassifier() *Classifier {
+	c := NewClassifier()
+	c.addBuiltin(GroupTypeCommon, isCommonByte)
+	c.addBuiltin(GroupTypeChinese, func(r rune) bool { return unicode.Is(unicode.Han, r) })
+	c.addBuiltin(GroupTypeLetters, unicode.IsLetter)
+	c.addBuiltin(GroupTypeDigits, unicode.IsDigit)
+	return c
+}
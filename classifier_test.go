@@ -0,0 +1,52 @@
+package string_group
+
+import "testing"
+
+func TestClassifierAddClassFirstMatchWins(t *testing.T) {
+	c := NewClassifier()
+	vowels := c.AddClass("vowel", func(r rune) bool { return r == 'a' || r == 'e' })
+	letters := c.AddClass("letter", func(r rune) bool { return r >= 'a' && r <= 'z' })
+
+	original := "bee"
+	groups := c.Split(original)
+
+	letterSegs := groups.GetSegmentsByType(letters)
+	vowelSegs := groups.GetSegmentsByType(vowels)
+
+	if len(letterSegs) != 1 || letterSegs[0].String(original) != "b" {
+		t.Fatalf("letter 分组应只包含 b，实际 %+v", letterSegs)
+	}
+	if len(vowelSegs) != 1 || vowelSegs[0].String(original) != "ee" {
+		t.Fatalf("先注册的 vowel 规则应优先于 letter 命中 ee，实际 %+v", vowelSegs)
+	}
+}
+
+func TestClassifierUnmatchedFallsBackToOthers(t *testing.T) {
+	c := NewClassifier()
+	c.AddClass("digit", func(r rune) bool { return r >= '0' && r <= '9' })
+
+	original := "a1"
+	groups := c.Split(original)
+	if len(groups.Others) != 1 || groups.Others[0].String(original) != "a" {
+		t.Fatalf("未命中任何规则的字符应归入 Others，实际 %+v", groups.Others)
+	}
+}
+
+func TestClassifierSetBoundaryDropsSeparator(t *testing.T) {
+	c := NewClassifier()
+	letters := c.AddClass("letter", func(r rune) bool { return r >= 'a' && r <= 'z' })
+	c.SetBoundary(func(r rune) bool { return r == ' ' })
+
+	original := "foo bar"
+	groups := c.Split(original)
+	segs := groups.GetSegmentsByType(letters)
+
+	var got []string
+	for _, seg := range segs {
+		got = append(got, seg.String(original))
+	}
+	want := []string{"foo", "bar"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("SetBoundary 应在命中处切分且丢弃分隔符本身，got %v want %v", got, want)
+	}
+}
@@ -0,0 +1,24 @@
+// Package collate 提供面向中文索引排序场景的 Collator 实现：按拼音、笔画数
+// 或部首对汉字字符串分组/排序，数据来自 internal/cjkdata 的内嵌表。
+package collate
+
+import "strings"
+
+// keyRunes 返回 seg 中参与键计算的 rune（由 isLetter 过滤非字母字符，如标点）。
+func keyRunes(seg string, isLetter func(rune) bool) []rune {
+	runes := make([]rune, 0, len(seg))
+	for _, r := range seg {
+		if isLetter(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// lessByKey 先比较 ka、kb 两个键，键相同时回退为 Unicode 码点序，以保证排序稳定。
+func lessByKey(ka, kb, a, b string) bool {
+	if ka != kb {
+		return ka < kb
+	}
+	return strings.Compare(a, b) < 0
+}
@@ -0,0 +1,48 @@
+package collate
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Servicewall/string-group/internal/cjkdata"
+)
+
+// PinyinCollator 按汉字拼音首字母对字符串分组/排序，未登录字符退化为 Unicode
+// 码点序，归入 "#" 分组。
+type PinyinCollator struct{}
+
+// IsLetter 仅汉字参与拼音键的计算。
+func (PinyinCollator) IsLetter(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+func (c PinyinCollator) key(seg string) string {
+	var b strings.Builder
+	for _, r := range keyRunes(seg, c.IsLetter) {
+		if initial, ok := cjkdata.PinyinInitial(r); ok {
+			b.WriteString(initial)
+		} else {
+			// 表中没有的字符退化为 Unicode 码点序。
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Group 返回 seg 中第一个汉字的拼音首字母（大写），没有已知拼音时返回 "#"。
+func (c PinyinCollator) Group(seg string) string {
+	runes := keyRunes(seg, c.IsLetter)
+	if len(runes) == 0 {
+		return "#"
+	}
+	initial, ok := cjkdata.PinyinInitial(runes[0])
+	if !ok || initial == "" {
+		return "#"
+	}
+	return strings.ToUpper(initial[:1])
+}
+
+// Less 按拼音键比较，键相同时回退为 Unicode 码点序。
+func (c PinyinCollator) Less(a, b string) bool {
+	return lessByKey(c.key(a), c.key(b), a, b)
+}
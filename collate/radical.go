@@ -0,0 +1,46 @@
+package collate
+
+import (
+	"unicode"
+
+	"github.com/Servicewall/string-group/internal/cjkdata"
+)
+
+// RadicalCollator 按汉字的康熙部首对字符串分组/排序，未登录字符退化为
+// Unicode 码点序，归入 "#" 分组。
+type RadicalCollator struct{}
+
+// IsLetter 仅汉字参与部首键的计算。
+func (RadicalCollator) IsLetter(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+func (c RadicalCollator) key(seg string) string {
+	key := make([]rune, 0, len(seg))
+	for _, r := range keyRunes(seg, c.IsLetter) {
+		if radical, ok := cjkdata.Radical(r); ok {
+			key = append(key, radical)
+		} else {
+			key = append(key, r)
+		}
+	}
+	return string(key)
+}
+
+// Group 返回 seg 中第一个汉字所属的部首字符，没有已知部首时返回 "#"。
+func (c RadicalCollator) Group(seg string) string {
+	runes := keyRunes(seg, c.IsLetter)
+	if len(runes) == 0 {
+		return "#"
+	}
+	radical, ok := cjkdata.Radical(runes[0])
+	if !ok {
+		return "#"
+	}
+	return string(radical)
+}
+
+// Less 按部首键比较，键相同时回退为 Unicode 码点序。
+func (c RadicalCollator) Less(a, b string) bool {
+	return lessByKey(c.key(a), c.key(b), a, b)
+}
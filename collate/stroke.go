@@ -0,0 +1,54 @@
+package collate
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/Servicewall/string-group/internal/cjkdata"
+)
+
+// StrokeCollator 按汉字笔画数对字符串分组/排序，未登录字符退化为 Unicode
+// 码点序，笔画数 30 及以上的字符统一归入 "30+" 分组。
+type StrokeCollator struct{}
+
+// IsLetter 仅汉字参与笔画键的计算。
+func (StrokeCollator) IsLetter(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+func (c StrokeCollator) key(seg string) string {
+	key := make([]rune, 0, len(seg))
+	for _, r := range keyRunes(seg, c.IsLetter) {
+		if strokes, ok := cjkdata.Strokes(r); ok {
+			// 以固定宽度编码笔画数，保证拼接后的键按笔画数高位优先比较。
+			for _, d := range fmt.Sprintf("%02d", strokes) {
+				key = append(key, d)
+			}
+		} else {
+			key = append(key, r)
+		}
+	}
+	return string(key)
+}
+
+// Group 返回 seg 中第一个汉字的笔画数分组（"1".."29"，30 及以上为 "30+"），
+// 没有已知笔画数时返回 "#"。
+func (c StrokeCollator) Group(seg string) string {
+	runes := keyRunes(seg, c.IsLetter)
+	if len(runes) == 0 {
+		return "#"
+	}
+	strokes, ok := cjkdata.Strokes(runes[0])
+	if !ok {
+		return "#"
+	}
+	if strokes >= 30 {
+		return "30+"
+	}
+	return fmt.Sprintf("%d", strokes)
+}
+
+// Less 按笔画键比较，键相同时回退为 Unicode 码点序。
+func (c StrokeCollator) Less(a, b string) bool {
+	return lessByKey(c.key(a), c.key(b), a, b)
+}
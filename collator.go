@@ -0,0 +1,41 @@
+package string_group
+
+import "sort"
+
+// Collator 定义了对字符串（通常是一个 Chinese 分组的内容）排序和分组的行为，
+// 抽象了拼音、笔画、部首等多种中文索引方式。
+type Collator interface {
+	// Group 返回 seg 所属的分组键，例如拼音首字母 "A".."Z"、笔画数 "1".."30+"
+	// 或部首字符。
+	Group(seg string) string
+	// Less 报告 a 是否应排在 b 之前。
+	Less(a, b string) bool
+	// IsLetter 报告 r 是否参与该 Collator 的排序/分组键计算；不参与的字符
+	// （如标点）在计算键时会被跳过。
+	IsLetter(r rune) bool
+}
+
+// SortChinese 返回 original 中所有 Chinese 分组按 c 排序后的副本。
+func (sg *StringGroups) SortChinese(original string, c Collator) []StringSegment {
+	result := make([]StringSegment, len(sg.Chinese))
+	copy(result, sg.Chinese)
+
+	sort.Slice(result, func(i, j int) bool {
+		return c.Less(result[i].String(original), result[j].String(original))
+	})
+	return result
+}
+
+// GroupChinese 将 original 中所有 Chinese 分组按 c.Group 返回的键分桶。
+func (sg *StringGroups) GroupChinese(original string, c Collator) map[string][]StringSegment {
+	if len(sg.Chinese) == 0 {
+		return nil
+	}
+
+	buckets := make(map[string][]StringSegment)
+	for _, seg := range sg.Chinese {
+		key := c.Group(seg.String(original))
+		buckets[key] = append(buckets[key], seg)
+	}
+	return buckets
+}
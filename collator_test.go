@@ -0,0 +1,83 @@
+package string_group
+
+import "testing"
+
+// reverseCollator is a minimal Collator used only to exercise SortChinese/
+// GroupChinese in isolation, without pulling in the collate subpackage's
+// pinyin/stroke/radical tables: Group keys by the first rune, Less orders
+// descending so a test can tell the comparator was actually used.
+type reverseCollator struct{}
+
+func (reverseCollator) Group(seg string) string {
+	if seg == "" {
+		return ""
+	}
+	return string([]rune(seg)[:1])
+}
+
+func (reverseCollator) Less(a, b string) bool {
+	return a > b
+}
+
+func (reverseCollator) IsLetter(r rune) bool {
+	return true
+}
+
+func TestSortChinese(t *testing.T) {
+	original := "北京-清华-上海"
+	groups := SplitIntoGroups(original)
+	if len(groups.Chinese) < 2 {
+		t.Fatalf("测试数据应至少包含两个 Chinese 分组，实际 %d", len(groups.Chinese))
+	}
+
+	c := reverseCollator{}
+	sorted := groups.SortChinese(original, c)
+	if len(sorted) != len(groups.Chinese) {
+		t.Fatalf("SortChinese 不应改变分段数量，got %d want %d", len(sorted), len(groups.Chinese))
+	}
+
+	for i := 1; i < len(sorted); i++ {
+		prev, cur := sorted[i-1].String(original), sorted[i].String(original)
+		if c.Less(cur, prev) {
+			t.Fatalf("SortChinese 未遵循 Collator.Less 排序: %v", sorted)
+		}
+	}
+
+	origSet := map[StringSegment]bool{}
+	for _, seg := range groups.Chinese {
+		origSet[seg] = true
+	}
+	for _, seg := range sorted {
+		if !origSet[seg] {
+			t.Fatalf("SortChinese 返回了不属于 Chinese 分组的段: %+v", seg)
+		}
+	}
+}
+
+func TestGroupChinese(t *testing.T) {
+	original := "北京-清华-上海"
+	groups := SplitIntoGroups(original)
+
+	buckets := groups.GroupChinese(original, reverseCollator{})
+
+	total := 0
+	for key, segs := range buckets {
+		for _, seg := range segs {
+			text := seg.String(original)
+			if string([]rune(text)[:1]) != key {
+				t.Fatalf("分组键 %q 与段 %q 的首字符不符", key, text)
+			}
+			total++
+		}
+	}
+	if total != len(groups.Chinese) {
+		t.Fatalf("GroupChinese 丢失了段: got %d want %d", total, len(groups.Chinese))
+	}
+}
+
+func TestGroupChineseNoChinese(t *testing.T) {
+	groups := SplitIntoGroups("abc123")
+	if buckets := groups.GroupChinese("abc123", reverseCollator{}); buckets != nil {
+		t.Fatalf("没有 Chinese 分组时应返回 nil，实际 %+v", buckets)
+	}
+}
@@ -0,0 +1,61 @@
+// Package cjkdata 提供用于中文排序/分组的只读查表数据：拼音首字母、笔画数和
+// 康熙部首。数据来自 data/cjk.tsv，可在不改动代码的情况下更新或扩充。
+package cjkdata
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+)
+
+//go:embed data/cjk.tsv
+var rawTable string
+
+var (
+	pinyinInitials = make(map[rune]string)
+	strokeCounts   = make(map[rune]uint8)
+	radicals       = make(map[rune]rune)
+)
+
+func init() {
+	for _, line := range strings.Split(rawTable, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 4 {
+			continue
+		}
+		chars := []rune(fields[0])
+		if len(chars) != 1 {
+			continue
+		}
+		r := chars[0]
+		pinyinInitials[r] = fields[1]
+		if strokes, err := strconv.Atoi(fields[2]); err == nil {
+			strokeCounts[r] = uint8(strokes)
+		}
+		if radicalRunes := []rune(fields[3]); len(radicalRunes) == 1 {
+			radicals[r] = radicalRunes[0]
+		}
+	}
+}
+
+// PinyinInitial 返回汉字 r 的拼音首字母（小写），若表中不存在则返回 ("", false)。
+func PinyinInitial(r rune) (string, bool) {
+	initial, ok := pinyinInitials[r]
+	return initial, ok
+}
+
+// Strokes 返回汉字 r 的笔画数，若表中不存在则返回 (0, false)。
+func Strokes(r rune) (uint8, bool) {
+	strokes, ok := strokeCounts[r]
+	return strokes, ok
+}
+
+// Radical 返回汉字 r 所属的康熙部首字符，若表中不存在则返回 (0, false)。
+func Radical(r rune) (rune, bool) {
+	radical, ok := radicals[r]
+	return radical, ok
+}
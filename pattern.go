@@ -0,0 +1,77 @@
+package string_group
+
+// patternToken 是参与模式匹配的一个分组类型标记过的字符串段。复用
+// MergeMultiGroups 内部的 mergeTyped 核心产出的 typedSegment，避免重复
+// 实现同一套按 Start 排序的 k 路归并逻辑。
+type patternToken = typedSegment
+
+// tokenStream 将 sg 中五类内置分组按原始字符串中的先后顺序合并为一条
+// 标记了分组类型的 token 序列，供 FindPattern 使用。
+func (sg *StringGroups) tokenStream() []patternToken {
+	return sg.mergeTyped(GroupTypeChinese, GroupTypeLetters, GroupTypeDigits, GroupTypeOthers, GroupTypeCommon)
+}
+
+// FindPattern 将 original 分组后的有序 token 流（跨所有内置分组类型，按原始
+// 字符串中的出现顺序排列）视作一个以分组类型为字母表的序列，并用一个小型
+// 类正则 DSL 做模式匹配。
+//
+// 模式语法：
+//   - 分组类型用单字母表示：C=Chinese, L=Letters, D=Digits, O=Others, K=Common
+//   - 量词 ?、+、* 作用于紧邻的原子（分组类型或括号分组），表示该原子在
+//     token 序列上重复出现的次数
+//   - 括号 (...) 分组，竖线 | 表示分支
+//   - 长度约束 L{3,8} 限制该 token 对应子串的长度（闭区间），L{3,} 只限制下限
+//   - 内容约束 L[/regex/] 要求该 token 对应子串匹配给定的 Go 正则表达式
+//
+// 匹配采用贪婪、非重叠的从左到右扫描：从每个未被占用的位置尝试匹配，命中后
+// 取可达的最长终止位置，并跳过其覆盖的 token 继续扫描。每次成功匹配返回其
+// 消耗的连续 StringSegment 切片。
+func (sg *StringGroups) FindPattern(original string, pattern string) [][]StringSegment {
+	tokens := sg.tokenStream()
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	root, err := parsePattern(pattern)
+	if err != nil {
+		return nil
+	}
+
+	// ctx 在整次扫描中复用，使 match(node, pos) 的结果以 (node, pos) 为键
+	// 被缓存——否则量词对可重复展开的子表达式（如 (L D)*）会在每个扫描
+	// 起点上都重新走一遍后续 token，整体退化为 O(n²)。
+	ctx := newMatchContext(tokens, original)
+
+	var matches [][]StringSegment
+	pos := 0
+	for pos < len(tokens) {
+		ends := matchPositions(root, ctx, pos)
+		if len(ends) == 0 {
+			pos++
+			continue
+		}
+		end := maxInt(ends)
+		if end == pos {
+			// 避免零宽匹配导致死循环。
+			pos++
+			continue
+		}
+		segs := make([]StringSegment, end-pos)
+		for i := pos; i < end; i++ {
+			segs[i-pos] = tokens[i].Segment
+		}
+		matches = append(matches, segs)
+		pos = end
+	}
+	return matches
+}
+
+func maxInt(set map[int]bool) int {
+	m := -1
+	for v := range set {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,448 @@
+package string_group
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// patternNode 是 FindPattern 模式编译后的抽象语法树节点。
+type patternNode interface {
+	// match 模拟以该节点为起点、Thompson 风格的 NFA：给定从 pos 出发，
+	// 返回所有可达的终止位置（token 下标）。结果只取决于节点本身和 pos
+	// （对固定的 ctx.tokens/ctx.original 而言），因此调用方应始终经由
+	// ctx 的 memo 表访问，而不是绕过它直接递归。
+	match(ctx *matchContext, pos int) map[int]bool
+}
+
+// matchContext 是一次 FindPattern 扫描内所有 patternNode.match 调用共享的
+// 上下文：持有 token 流，并以 (节点, pos) 为键缓存匹配结果。
+//
+// FindPattern 在一次扫描中会从每个未匹配的 token 位置重新尝试整棵模式树，
+// 而 concatNode/repeatNode 在匹配过程中又会在多个中间位置递归调用子节点。
+// 由于 match 的结果只是 (节点, pos) 的纯函数，同一个 (节点, pos) 在不同的
+// 扫描起点、不同的中间帧下会被反复访问到——不做缓存时，形如 (L D)* 这样
+// 在交替出现的 token 上可以持续展开的量词会让整体复杂度退化为 O(n²)甚至
+// 更差。缓存后每个 (节点, pos) 只计算一次，整次扫描退化为 O(节点数×n)。
+type matchContext struct {
+	tokens   []patternToken
+	original string
+	memo     map[int64]map[int]bool
+}
+
+func newMatchContext(tokens []patternToken, original string) *matchContext {
+	return &matchContext{tokens: tokens, original: original, memo: make(map[int64]map[int]bool)}
+}
+
+func memoKey(nodeID, pos int) int64 {
+	return int64(nodeID)<<32 | int64(uint32(pos))
+}
+
+// memoized 返回 nodeID 在 pos 处的缓存结果，缺失时调用 compute 计算并存入缓存。
+func (ctx *matchContext) memoized(nodeID, pos int, compute func() map[int]bool) map[int]bool {
+	key := memoKey(nodeID, pos)
+	if result, ok := ctx.memo[key]; ok {
+		return result
+	}
+	result := compute()
+	ctx.memo[key] = result
+	return result
+}
+
+// tokenPred 描述一个单 token 需要满足的条件：分组类型、长度区间（闭区间，
+// -1 表示不限）以及可选的内容正则。
+type tokenPred struct {
+	groupType GroupType
+	minLen    int
+	maxLen    int
+	contentRE *regexp.Regexp
+}
+
+func (p tokenPred) matches(tok patternToken, original string) bool {
+	if tok.Type != p.groupType {
+		return false
+	}
+	length := tok.Segment.End - tok.Segment.Start
+	if p.minLen >= 0 && length < p.minLen {
+		return false
+	}
+	if p.maxLen >= 0 && length > p.maxLen {
+		return false
+	}
+	if p.contentRE != nil && !p.contentRE.MatchString(tok.Segment.String(original)) {
+		return false
+	}
+	return true
+}
+
+// tokenNode 匹配序列中的单个 token。
+type tokenNode struct {
+	pred   tokenPred
+	nodeID int
+}
+
+func (n tokenNode) match(ctx *matchContext, pos int) map[int]bool {
+	return ctx.memoized(n.nodeID, pos, func() map[int]bool {
+		if pos >= len(ctx.tokens) || !n.pred.matches(ctx.tokens[pos], ctx.original) {
+			return nil
+		}
+		return map[int]bool{pos + 1: true}
+	})
+}
+
+// concatNode 依次匹配 items，每一项的可达终止位置是下一项的起点集合。
+type concatNode struct {
+	items  []patternNode
+	nodeID int
+}
+
+func (n concatNode) match(ctx *matchContext, pos int) map[int]bool {
+	return ctx.memoized(n.nodeID, pos, func() map[int]bool {
+		frontier := map[int]bool{pos: true}
+		for _, item := range n.items {
+			next := map[int]bool{}
+			for p := range frontier {
+				for end := range item.match(ctx, p) {
+					next[end] = true
+				}
+			}
+			if len(next) == 0 {
+				return nil
+			}
+			frontier = next
+		}
+		return frontier
+	})
+}
+
+// altNode 是多个分支的并集（对应 NFA 中的 epsilon 分叉）。
+type altNode struct {
+	options []patternNode
+	nodeID  int
+}
+
+func (n altNode) match(ctx *matchContext, pos int) map[int]bool {
+	return ctx.memoized(n.nodeID, pos, func() map[int]bool {
+		result := map[int]bool{}
+		for _, opt := range n.options {
+			for end := range opt.match(ctx, pos) {
+				result[end] = true
+			}
+		}
+		return result
+	})
+}
+
+// repeatNode 将 child 重复 min 到 max 次（max < 0 表示无上限），对应
+// ?（0,1）、+（1,-1）、*（0,-1）。
+type repeatNode struct {
+	child  patternNode
+	min    int
+	max    int
+	nodeID int
+}
+
+func (n repeatNode) match(ctx *matchContext, pos int) map[int]bool {
+	return ctx.memoized(n.nodeID, pos, func() map[int]bool {
+		result := map[int]bool{}
+		if n.min == 0 {
+			result[pos] = true
+		}
+
+		frontier := map[int]bool{pos: true}
+		seenFrontiers := map[string]bool{frontierKey(frontier): true}
+		for count := 1; n.max < 0 || count <= n.max; count++ {
+			next := map[int]bool{}
+			for p := range frontier {
+				for end := range n.child.match(ctx, p) {
+					next[end] = true
+				}
+			}
+			if len(next) == 0 {
+				break
+			}
+			if count >= n.min {
+				for end := range next {
+					result[end] = true
+				}
+			}
+			// child 可以零宽匹配（例如被量词包裹的可空子表达式）时，frontier 会
+			// 收敛到此前已经出现过的同一组位置，继续重复不会再产生新的可达
+			// 位置，必须在此退出，否则无界量词会无限循环下去。
+			key := frontierKey(next)
+			if seenFrontiers[key] {
+				break
+			}
+			seenFrontiers[key] = true
+			frontier = next
+		}
+		return result
+	})
+}
+
+// frontierKey 把一组 token 位置编码为可比较的字符串键，用于在 repeatNode
+// 的展开过程中检测 frontier 是否已经出现过（从而判断是否陷入零宽循环）。
+func frontierKey(positions map[int]bool) string {
+	ids := make([]int, 0, len(positions))
+	for p := range positions {
+		ids = append(ids, p)
+	}
+	sort.Ints(ids)
+
+	var b strings.Builder
+	for i, id := range ids {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(id))
+	}
+	return b.String()
+}
+
+// matchPositions 是 patternNode.match 的包级便捷包装。
+func matchPositions(n patternNode, ctx *matchContext, pos int) map[int]bool {
+	return n.match(ctx, pos)
+}
+
+// classTypes 将 DSL 中的分组字母映射到内置 GroupType。
+var classTypes = map[rune]GroupType{
+	'C': GroupTypeChinese,
+	'L': GroupTypeLetters,
+	'D': GroupTypeDigits,
+	'O': GroupTypeOthers,
+	'K': GroupTypeCommon,
+}
+
+// patternParser 对 FindPattern 的模式串做递归下降解析。
+type patternParser struct {
+	runes  []rune
+	pos    int
+	nextID int
+}
+
+// newID 为新构造的节点分配一个在本次解析内唯一的 id，供 matchContext 的
+// memo 表做键使用。
+func (p *patternParser) newID() int {
+	id := p.nextID
+	p.nextID++
+	return id
+}
+
+func parsePattern(pattern string) (patternNode, error) {
+	p := &patternParser{runes: []rune(pattern)}
+	node, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.runes) {
+		return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处存在多余内容", p.pos)
+	}
+	return node, nil
+}
+
+func (p *patternParser) peek() (rune, bool) {
+	if p.pos >= len(p.runes) {
+		return 0, false
+	}
+	return p.runes[p.pos], true
+}
+
+// skipSpace 跳过结构位置上的空白字符，使 "L+ D{2,4}" 这样带空格分隔的模式串
+// 与不带空格的写法等价。正则/长度约束体内部不会调用它，空白在那里原样保留。
+func (p *patternParser) skipSpace() {
+	for p.pos < len(p.runes) && (p.runes[p.pos] == ' ' || p.runes[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *patternParser) parseAlt() (patternNode, error) {
+	first, err := p.parseConcat()
+	if err != nil {
+		return nil, err
+	}
+	options := []patternNode{first}
+	for {
+		r, ok := p.peek()
+		if !ok || r != '|' {
+			break
+		}
+		p.pos++
+		next, err := p.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, next)
+	}
+	if len(options) == 1 {
+		return options[0], nil
+	}
+	return altNode{options: options, nodeID: p.newID()}, nil
+}
+
+func (p *patternParser) parseConcat() (patternNode, error) {
+	var items []patternNode
+	for {
+		p.skipSpace()
+		r, ok := p.peek()
+		if !ok || r == '|' || r == ')' {
+			break
+		}
+		item, err := p.parseQuantified()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处期望一个表达式", p.pos)
+	}
+	if len(items) == 1 {
+		return items[0], nil
+	}
+	return concatNode{items: items, nodeID: p.newID()}, nil
+}
+
+func (p *patternParser) parseQuantified() (patternNode, error) {
+	atom, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	r, ok := p.peek()
+	if !ok {
+		return atom, nil
+	}
+	switch r {
+	case '?':
+		p.pos++
+		return repeatNode{child: atom, min: 0, max: 1, nodeID: p.newID()}, nil
+	case '+':
+		p.pos++
+		return repeatNode{child: atom, min: 1, max: -1, nodeID: p.newID()}, nil
+	case '*':
+		p.pos++
+		return repeatNode{child: atom, min: 0, max: -1, nodeID: p.newID()}, nil
+	default:
+		return atom, nil
+	}
+}
+
+func (p *patternParser) parseAtom() (patternNode, error) {
+	r, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("string_group: 模式串意外结束")
+	}
+
+	if r == '(' {
+		p.pos++
+		inner, err := p.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok || closing != ')' {
+			return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处缺少 ')'", p.pos)
+		}
+		p.pos++
+		return inner, nil
+	}
+
+	groupType, ok := classTypes[r]
+	if !ok {
+		return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处存在未知分组类型 %q", p.pos, r)
+	}
+	p.pos++
+
+	pred := tokenPred{groupType: groupType, minLen: -1, maxLen: -1}
+
+	if r, ok := p.peek(); ok && r == '{' {
+		minLen, maxLen, err := p.parseLengthConstraint()
+		if err != nil {
+			return nil, err
+		}
+		pred.minLen, pred.maxLen = minLen, maxLen
+	}
+
+	if r, ok := p.peek(); ok && r == '[' {
+		re, err := p.parseContentConstraint()
+		if err != nil {
+			return nil, err
+		}
+		pred.contentRE = re
+	}
+
+	return tokenNode{pred: pred, nodeID: p.newID()}, nil
+}
+
+// parseLengthConstraint 解析 "{n}"、"{n,m}" 或 "{n,}" 形式的长度约束，p.pos
+// 在调用时指向 '{'。
+func (p *patternParser) parseLengthConstraint() (min, max int, err error) {
+	start := p.pos
+	p.pos++ // 跳过 '{'
+	closeIdx := -1
+	for i := p.pos; i < len(p.runes); i++ {
+		if p.runes[i] == '}' {
+			closeIdx = i
+			break
+		}
+	}
+	if closeIdx == -1 {
+		return 0, 0, fmt.Errorf("string_group: 模式串第 %d 个字符处的长度约束缺少 '}'", start)
+	}
+	body := string(p.runes[p.pos:closeIdx])
+	p.pos = closeIdx + 1
+
+	parts := strings.SplitN(body, ",", 2)
+	minLen, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("string_group: 长度约束 {%s} 非法: %w", body, err)
+	}
+	if len(parts) == 1 {
+		return minLen, minLen, nil
+	}
+	maxStr := strings.TrimSpace(parts[1])
+	if maxStr == "" {
+		return minLen, -1, nil
+	}
+	maxLen, err := strconv.Atoi(maxStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("string_group: 长度约束 {%s} 非法: %w", body, err)
+	}
+	return minLen, maxLen, nil
+}
+
+// parseContentConstraint 解析 "[/regex/]" 形式的内容约束，p.pos 在调用时
+// 指向 '['。
+func (p *patternParser) parseContentConstraint() (*regexp.Regexp, error) {
+	start := p.pos
+	p.pos++ // 跳过 '['
+	if r, ok := p.peek(); !ok || r != '/' {
+		return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处期望 '/'", p.pos)
+	}
+	p.pos++ // 跳过开头的 '/'
+
+	slashIdx := -1
+	for i := p.pos; i < len(p.runes); i++ {
+		if p.runes[i] == '/' {
+			slashIdx = i
+			break
+		}
+	}
+	if slashIdx == -1 {
+		return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处的内容约束缺少结尾 '/'", start)
+	}
+	src := string(p.runes[p.pos:slashIdx])
+	p.pos = slashIdx + 1
+
+	if r, ok := p.peek(); !ok || r != ']' {
+		return nil, fmt.Errorf("string_group: 模式串第 %d 个字符处的内容约束缺少 ']'", p.pos)
+	}
+	p.pos++
+
+	re, err := regexp.Compile(src)
+	if err != nil {
+		return nil, fmt.Errorf("string_group: 内容约束正则 /%s/ 非法: %w", src, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,85 @@
+package string_group
+
+import (
+	"testing"
+	"time"
+)
+
+func segStrings(original string, matches [][]StringSegment) []string {
+	var out []string
+	for _, m := range matches {
+		s := ""
+		for _, seg := range m {
+			s += seg.String(original)
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func TestFindPatternBasic(t *testing.T) {
+	original := "abc123EFG"
+	groups := SplitIntoGroups(original)
+
+	matches := groups.FindPattern(original, "L+D{2,4}")
+	got := segStrings(original, matches)
+	want := []string{"abc123"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("L+D{2,4} 匹配结果不符: got %v want %v", got, want)
+	}
+}
+
+func TestFindPatternOptionalGroup(t *testing.T) {
+	original := "abc123-EFG"
+	groups := SplitIntoGroups(original)
+
+	matches := groups.FindPattern(original, "L+D{2,4}(K L+)?")
+	got := segStrings(original, matches)
+	want := []string{"abc123-EFG"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("L+D{2,4}(K L+)? 匹配结果不符: got %v want %v", got, want)
+	}
+}
+
+func TestFindPatternContentConstraint(t *testing.T) {
+	original := "foo42bar"
+	groups := SplitIntoGroups(original)
+
+	matches := groups.FindPattern(original, "L[/^foo$/]")
+	got := segStrings(original, matches)
+	want := []string{"foo"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("内容约束匹配结果不符: got %v want %v", got, want)
+	}
+}
+
+func TestFindPatternNoMatch(t *testing.T) {
+	original := "abc"
+	groups := SplitIntoGroups(original)
+
+	if matches := groups.FindPattern(original, "D+"); matches != nil {
+		t.Fatalf("期望没有匹配，实际 %+v", matches)
+	}
+}
+
+// TestFindPatternNullableUnboundedRepeat 是一个回归测试：当无界量词（+、*）包裹
+// 的子表达式可以零宽匹配时（如 C?、C*），必须能在有限步内终止，而不是在
+// frontier 收敛到同一组位置后仍不断重复。
+func TestFindPatternNullableUnboundedRepeat(t *testing.T) {
+	original := "abc123"
+	groups := SplitIntoGroups(original)
+
+	patterns := []string{"(C?)+", "(C*)*"}
+	for _, pattern := range patterns {
+		done := make(chan struct{})
+		go func() {
+			groups.FindPattern(original, pattern)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(3 * time.Second):
+			t.Fatalf("FindPattern(%q) 未在预期时间内返回，疑似陷入死循环", pattern)
+		}
+	}
+}
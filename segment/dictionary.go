@@ -0,0 +1,162 @@
+// Package segment 提供基于词典的中文分词能力，供 string_group 包对 Chinese
+// 分组做进一步细分使用。
+package segment
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// unknownLogProb 是未登录单字的惩罚对数概率，足够小以保证词典命中优先于单字兜底。
+const unknownLogProb = -20.0
+
+// trieNode 是词典前缀树的节点。
+type trieNode struct {
+	children map[rune]*trieNode
+	isWord   bool
+	freq     int
+	pos      string
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[rune]*trieNode)}
+}
+
+// Dictionary 是加载后的分词词典，内部以前缀树组织以支持按位置的多长度匹配。
+type Dictionary struct {
+	root      *trieNode
+	totalFreq int64
+	logTotal  float64
+}
+
+// entry 对应词典文件中的一行：{text, frequency, pos}。
+type entry struct {
+	text string
+	freq int
+	pos  string
+}
+
+// LoadDictionary 加载一个或多个词典文件并构建 Dictionary。
+// 词典文件每行一个条目，格式为 "词语 词频 [词性]"，字段以空白分隔。
+// 多个文件按顺序加载，先加载的文件在词语重复时优先生效。
+func LoadDictionary(paths ...string) (*Dictionary, error) {
+	return LoadDictionaryWithMinFrequency(0, paths...)
+}
+
+// LoadDictionaryWithMinFrequency 与 LoadDictionary 相同，但会丢弃词频低于
+// minTokenFrequency 的词条，用于裁剪词典规模或过滤噪声词。
+func LoadDictionaryWithMinFrequency(minTokenFrequency int, paths ...string) (*Dictionary, error) {
+	d := &Dictionary{root: newTrieNode()}
+
+	seen := make(map[string]bool)
+	var total int64
+	for _, path := range paths {
+		entries, err := readDictFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("segment: 加载词典 %s 失败: %w", path, err)
+		}
+		for _, e := range entries {
+			if e.freq < minTokenFrequency {
+				continue
+			}
+			if seen[e.text] {
+				continue // 先加载的文件优先，后续重复词条忽略
+			}
+			seen[e.text] = true
+			d.insert(e.text, e.freq, e.pos)
+			total += int64(e.freq)
+		}
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("segment: 词典为空或所有词条均被 minTokenFrequency 过滤")
+	}
+	d.totalFreq = total
+	d.logTotal = math.Log(float64(total))
+	return d, nil
+}
+
+func readDictFile(path string) ([]entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		freq, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		pos := ""
+		if len(fields) >= 3 {
+			pos = fields[2]
+		}
+		entries = append(entries, entry{text: fields[0], freq: freq, pos: pos})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (d *Dictionary) insert(word string, freq int, pos string) {
+	node := d.root
+	for _, r := range word {
+		child, ok := node.children[r]
+		if !ok {
+			child = newTrieNode()
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.isWord = true
+	node.freq = freq
+	node.pos = pos
+}
+
+// WordMatch 是 MatchesAt 返回的一次词典命中，长度以 rune 计。
+type WordMatch struct {
+	Length         int
+	LogProbability float64
+}
+
+// MatchesAt 返回词典中所有以 runes[pos:] 为前缀匹配到的词，按命中长度从短到长排列。
+// 调用方通常在 Viterbi 动态规划中以此枚举某个位置上所有可能的词边界。
+func (d *Dictionary) MatchesAt(runes []rune, pos int) []WordMatch {
+	var matches []WordMatch
+	node := d.root
+	for i := pos; i < len(runes); i++ {
+		child, ok := node.children[runes[i]]
+		if !ok {
+			break
+		}
+		node = child
+		if node.isWord {
+			matches = append(matches, WordMatch{
+				Length:         i - pos + 1,
+				LogProbability: math.Log(float64(node.freq)) - d.logTotal,
+			})
+		}
+	}
+	return matches
+}
+
+// UnknownLogProbability 是未登录单字的惩罚对数概率，保证任意字符都能兜底切分。
+func (d *Dictionary) UnknownLogProbability() float64 {
+	return unknownLogProb
+}
@@ -0,0 +1,84 @@
+package segment
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeDict(t *testing.T, lines string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "dict.txt")
+	if err := os.WriteFile(path, []byte(lines), 0o644); err != nil {
+		t.Fatalf("写入测试词典失败: %v", err)
+	}
+	return path
+}
+
+func TestLoadDictionaryAndMatchesAt(t *testing.T) {
+	path := writeDict(t, "用户名 100 n\n用户 200 n\n名 50 n\n")
+	dict, err := LoadDictionary(path)
+	if err != nil {
+		t.Fatalf("加载词典失败: %v", err)
+	}
+
+	runes := []rune("用户名")
+	matches := dict.MatchesAt(runes, 0)
+	lengths := make(map[int]bool)
+	for _, m := range matches {
+		lengths[m.Length] = true
+	}
+	if !lengths[2] || !lengths[3] {
+		t.Fatalf("期望在位置0命中长度2(用户)和长度3(用户名)，实际 %+v", matches)
+	}
+
+	matches = dict.MatchesAt(runes, 2)
+	if len(matches) != 1 || matches[0].Length != 1 {
+		t.Fatalf("期望在位置2只命中长度1(名)，实际 %+v", matches)
+	}
+}
+
+func TestLoadDictionaryMinFrequency(t *testing.T) {
+	path := writeDict(t, "热词 100 n\n冷词 1 n\n")
+
+	dict, err := LoadDictionaryWithMinFrequency(10, path)
+	if err != nil {
+		t.Fatalf("加载词典失败: %v", err)
+	}
+
+	hot := []rune("热词")
+	if matches := dict.MatchesAt(hot, 0); len(matches) != 1 {
+		t.Fatalf("期望热词仍被保留，实际 %+v", matches)
+	}
+
+	cold := []rune("冷词")
+	if matches := dict.MatchesAt(cold, 0); len(matches) != 0 {
+		t.Fatalf("期望低频词被 minTokenFrequency 过滤，实际 %+v", matches)
+	}
+}
+
+func TestLoadDictionaryEarlierFileWins(t *testing.T) {
+	// 额外放入一个词，使"词"的对数概率取决于其被保留的词频：
+	// 保留 100 时为 log(100/200)，被 1 覆盖时则不同。
+	high := writeDict(t, "词 100 n\n其他 100 n\n")
+	low := writeDict(t, "词 1 n\n")
+
+	dict, err := LoadDictionary(high, low)
+	if err != nil {
+		t.Fatalf("加载词典失败: %v", err)
+	}
+	matches := dict.MatchesAt([]rune("词"), 0)
+	if len(matches) != 1 {
+		t.Fatalf("期望命中一次，实际 %+v", matches)
+	}
+	// 先加载的文件优先，其频率（100）应该被保留，而不是被后加载的 1 覆盖。
+	wantLogProb := matches[0].LogProbability
+	dictHighOnly, err := LoadDictionary(high)
+	if err != nil {
+		t.Fatalf("加载词典失败: %v", err)
+	}
+	if got := dictHighOnly.MatchesAt([]rune("词"), 0)[0].LogProbability; got != wantLogProb {
+		t.Fatalf("先加载文件的词频未被保留: got=%v want=%v", got, wantLogProb)
+	}
+}
@@ -0,0 +1,18 @@
+package string_group
+
+// SplitIter 以流式回调的方式产出 s 的默认五类分组结果：每当一个分组段结束
+// 就立即调用 yield，而不是像 SplitIntoGroups 那样先把所有段收集进五个切片
+// 再返回，适合处理日志、网页抓取结果等体积很大的输入。yield 返回 false 时
+// 停止扫描。
+//
+// 其签名与 Go 1.23 range-over-func 的双值迭代器（iter.Seq2[GroupType,
+// StringSegment]）兼容，因此在支持该特性的工具链下可以直接对一个绑定了 s 的
+// 闭包做 for-range。更常见的用法是直接传入回调：
+//
+//	string_group.SplitIter(s, func(t GroupType, seg StringSegment) bool {
+//		// 处理 seg
+//		return true
+//	})
+func SplitIter(s string, yield func(GroupType, StringSegment) bool) {
+	defaultClassifier().splitIter(s, yield)
+}
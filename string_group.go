@@ -1,6 +1,7 @@
 package string_group
 
 import (
+	"sort"
 	"unicode"
 	"unicode/utf8"
 )
@@ -24,6 +25,10 @@ type StringGroups struct {
 	Digits  []StringSegment // 数字段
 	Others  []StringSegment // 其他字符段
 	Commons []StringSegment // 通用段，包括*，X，x
+
+	// Extra 存储通过 Classifier.AddClass 注册的自定义分组，键为 AddClass
+	// 返回的 GroupType。内置五类分组不会出现在这里。
+	Extra map[GroupType][]StringSegment
 }
 
 // StringSegment 表示原始字符串中的一个子串段
@@ -54,24 +59,60 @@ func (sg *StringGroups) GetSegmentsByType(groupType GroupType) []StringSegment {
 	case GroupTypeCommon:
 		return sg.Commons
 	default:
-		return nil
+		return sg.Extra[groupType]
 	}
 }
 
-// MergeMultiGroups 合并多个指定类型的分组
-func (sg *StringGroups) MergeMultiGroups(types ...GroupType) []StringSegment {
+// appendSegment 将 seg 追加到 groupType 对应的分组，内置五类写入专用字段，
+// 其余（Classifier 自定义）类型写入 Extra。
+func (sg *StringGroups) appendSegment(groupType GroupType, seg StringSegment) {
+	switch groupType {
+	case GroupTypeChinese:
+		sg.Chinese = append(sg.Chinese, seg)
+	case GroupTypeLetters:
+		sg.Letters = append(sg.Letters, seg)
+	case GroupTypeDigits:
+		sg.Digits = append(sg.Digits, seg)
+	case GroupTypeOthers:
+		sg.Others = append(sg.Others, seg)
+	case GroupTypeCommon:
+		sg.Commons = append(sg.Commons, seg)
+	default:
+		if sg.Extra == nil {
+			sg.Extra = make(map[GroupType][]StringSegment)
+		}
+		sg.Extra[groupType] = append(sg.Extra[groupType], seg)
+	}
+}
+
+// typedSegment 是带有来源分组类型标记的字符串段，供需要在合并多个分组后
+// 仍保留每个段原始类型的场景使用（如 FindPattern 的 token 流）。
+type typedSegment struct {
+	Segment StringSegment
+	Type    GroupType
+}
+
+// mergeTyped 是 MergeMultiGroups 与 FindPattern 共用的 k 路归并核心：按
+// Start 升序合并 types 对应的各个（本身已按 Start 升序排列的）分组，返回
+// 带类型标记的段序列。MergeMultiGroups 在此基础上剥离类型标记；token 流则
+// 需要保留它来判断每个段的分组类型。
+func (sg *StringGroups) mergeTyped(types ...GroupType) []typedSegment {
 	if len(types) == 0 {
 		return nil
 	}
 
 	// 获取所有分组并计算总容量
-	groups := make([][]StringSegment, 0, len(types))
+	type group struct {
+		groupType GroupType
+		segments  []StringSegment
+	}
+	groups := make([]group, 0, len(types))
 	totalSize := 0
 	for _, t := range types {
-		group := sg.GetSegmentsByType(t)
-		if len(group) > 0 {
-			groups = append(groups, group)
-			totalSize += len(group)
+		segments := sg.GetSegmentsByType(t)
+		if len(segments) > 0 {
+			groups = append(groups, group{groupType: t, segments: segments})
+			totalSize += len(segments)
 		}
 	}
 
@@ -80,13 +121,8 @@ func (sg *StringGroups) MergeMultiGroups(types ...GroupType) []StringSegment {
 		return nil
 	}
 
-	// 如果只有一个分组，直接返回
-	if len(groups) == 1 {
-		return groups[0]
-	}
-
 	// 创建结果切片
-	result := make([]StringSegment, 0, totalSize)
+	result := make([]typedSegment, 0, totalSize)
 
 	// 使用归并排序的思想合并多个已排序的切片
 	// 为每个分组创建一个索引
@@ -98,9 +134,9 @@ func (sg *StringGroups) MergeMultiGroups(types ...GroupType) []StringSegment {
 		minStart := -1
 
 		// 找出当前所有分组中Start最小的段
-		for i, group := range groups {
-			if indices[i] < len(group) {
-				curStart := group[indices[i]].Start
+		for i, g := range groups {
+			if indices[i] < len(g.segments) {
+				curStart := g.segments[indices[i]].Start
 				if minIdx == -1 || curStart < minStart {
 					minIdx = i
 					minStart = curStart
@@ -114,13 +150,28 @@ func (sg *StringGroups) MergeMultiGroups(types ...GroupType) []StringSegment {
 		}
 
 		// 将找到的最小段添加到结果中
-		result = append(result, groups[minIdx][indices[minIdx]])
+		g := groups[minIdx]
+		result = append(result, typedSegment{Segment: g.segments[indices[minIdx]], Type: g.groupType})
 		indices[minIdx]++
 	}
 
 	return result
 }
 
+// MergeMultiGroups 合并多个指定类型的分组
+func (sg *StringGroups) MergeMultiGroups(types ...GroupType) []StringSegment {
+	merged := sg.mergeTyped(types...)
+	if merged == nil {
+		return nil
+	}
+
+	result := make([]StringSegment, len(merged))
+	for i, m := range merged {
+		result[i] = m.Segment
+	}
+	return result
+}
+
 // MergeMultiGroupsWithContinuousIntervals 合并多个指定类型的分组，并连接连续的区间
 func (sg *StringGroups) MergeMultiGroupsWithContinuousIntervals(originalStr string, boundaryCheck bool, maxlength, minLength int, types ...GroupType) []StringSegment {
 	// 先合并多个分组
@@ -169,16 +220,6 @@ func connectContinuousIntervals(originalStr string, boundaryCheck bool, maxlengt
 	return result
 }
 
-// 字符类型常量
-const (
-	typeUnknown = iota // 初始状态或空白字符
-	typeChinese        // 汉字
-	typeLetters        // 字母
-	typeDigits         // 数字
-	typeOther          // 其他非空白字符
-	typeCommon         // 通用字符
-)
-
 var commonBytes = []byte{'*', 'X', 'x', '-', '(', ')', '_', '.', '@'}
 
 func isCommonByte(b int32) bool {
@@ -190,83 +231,11 @@ func isCommonByte(b int32) bool {
 	return false
 }
 
-// SplitIntoGroups 将字符串分为汉字、字母、数字和其他字符四组
+// SplitIntoGroups 将字符串分为汉字、字母、数字、其他字符和通用字符五组。
+// 这是对默认 Classifier 的薄封装，规则与历史行为保持一致：依次判断通用字符、
+// 汉字、字母、数字，其余归入 Others。需要自定义分组规则时请使用 Classifier。
 func SplitIntoGroups(s string) StringGroups {
-	// 预分配切片
-	result := StringGroups{
-		Chinese: make([]StringSegment, 0, len(s)/8+1), // 假设约1/8的字符是汉字
-		Letters: make([]StringSegment, 0, len(s)/8+1), // 假设约1/8的字符是字母
-		Digits:  make([]StringSegment, 0, len(s)/8+1), // 假设约1/8的字符是数字
-		Others:  make([]StringSegment, 0, len(s)/8+1), // 假设约1/8的字符是其他非空白字符
-		Commons: make([]StringSegment, 0, len(s)/8+1), // 假设约1/8的字符是其他非空白字符
-	}
-
-	start := 0                 // 当前段的起始位置
-	currentType := typeUnknown // 当前正在处理的字符类型
-	hasSegment := false        // 是否已经开始一个段
-
-	// 遍历字符串中的每个字符
-	for i, r := range s {
-		// 判断字符类型
-		var charType int
-		if isCommonByte(r) {
-			charType = typeCommon
-		} else if unicode.Is(unicode.Han, r) {
-			charType = typeChinese
-		} else if unicode.IsLetter(r) {
-			charType = typeLetters
-		} else if unicode.IsDigit(r) {
-			charType = typeDigits
-		} else {
-			charType = typeOther
-		}
-
-		// 如果这是一个新段或字符类型发生变化
-		if !hasSegment {
-			// 开始新段
-			start = i
-			hasSegment = true
-			currentType = charType
-		} else if charType != currentType {
-			// 字符类型变化，结束当前段并开始新段
-			seg := StringSegment{Start: start, End: i}
-			switch currentType {
-			case typeChinese:
-				result.Chinese = append(result.Chinese, seg)
-			case typeLetters:
-				result.Letters = append(result.Letters, seg)
-			case typeDigits:
-				result.Digits = append(result.Digits, seg)
-			case typeCommon:
-				result.Commons = append(result.Commons, seg)
-			case typeOther:
-				result.Others = append(result.Others, seg)
-			}
-
-			// 开始新段
-			start = i
-			currentType = charType
-		}
-	}
-
-	// 处理最后一个分组
-	if hasSegment {
-		seg := StringSegment{Start: start, End: len(s)}
-		switch currentType {
-		case typeChinese:
-			result.Chinese = append(result.Chinese, seg)
-		case typeLetters:
-			result.Letters = append(result.Letters, seg)
-		case typeDigits:
-			result.Digits = append(result.Digits, seg)
-		case typeCommon:
-			result.Commons = append(result.Commons, seg)
-		case typeOther:
-			result.Others = append(result.Others, seg)
-		}
-	}
-
-	return result
+	return defaultClassifier().Split(s)
 }
 
 // FilterSegmentsByIntervals 过滤并裁剪[]StringSegment，只保留与给定区间有交集的部分
@@ -288,13 +257,7 @@ func FilterSegmentsByIntervals(intervals [][]int, segments []StringSegment) []St
 		ivls[i] = interval{iv[0], iv[1]}
 	}
 	// 排序
-	for i := 0; i < len(ivls)-1; i++ {
-		for j := i + 1; j < len(ivls); j++ {
-			if ivls[i].start > ivls[j].start {
-				ivls[i], ivls[j] = ivls[j], ivls[i]
-			}
-		}
-	}
+	sort.Slice(ivls, func(i, j int) bool { return ivls[i].start < ivls[j].start })
 
 	result := make([]StringSegment, 0)
 	ivlIdx := 0